@@ -0,0 +1,12 @@
+package main
+
+import (
+	"github.com/betawaffle/terraform-provider-packet/packet"
+	"github.com/hashicorp/terraform/plugin"
+)
+
+func main() {
+	plugin.Serve(&plugin.ServeOpts{
+		ProviderFunc: packet.Provider,
+	})
+}
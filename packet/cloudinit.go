@@ -0,0 +1,61 @@
+package packet
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+)
+
+// renderUserDataParts assembles one or more user_data_part blocks into a
+// single MIME multipart cloud-init payload, the same way cloud-init itself
+// expects #include and cloud-config fragments to be combined.
+func renderUserDataParts(parts []interface{}) (string, error) {
+	var buf bytes.Buffer
+
+	writer := multipart.NewWriter(&buf)
+	// cloud-init identifies the boundary from the Content-Type header of the
+	// request body, not the MIME preamble, so a fixed boundary is fine here.
+	if err := writer.SetBoundary("MIMEBOUNDARY"); err != nil {
+		return "", err
+	}
+
+	for i, p := range parts {
+		part := p.(map[string]interface{})
+
+		contentType := part["content_type"].(string)
+		if contentType == "" {
+			contentType = "text/x-shellscript"
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", fmt.Sprintf("%s; charset=\"us-ascii\"", contentType))
+		header.Set("MIME-Version", "1.0")
+		header.Set("Content-Transfer-Encoding", "7bit")
+
+		filename := part["filename"].(string)
+		if filename == "" {
+			filename = fmt.Sprintf("part-%03d", i+1)
+		}
+		header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", filename))
+
+		if mergeType := part["merge_type"].(string); mergeType != "" {
+			header.Set("X-Merge-Type", mergeType)
+		}
+
+		w, err := writer.CreatePart(header)
+		if err != nil {
+			return "", err
+		}
+
+		if _, err := w.Write([]byte(part["content"].(string))); err != nil {
+			return "", err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("Content-Type: multipart/mixed; boundary=\"%s\"\nMIME-Version: 1.0\n\n%s", writer.Boundary(), buf.String()), nil
+}
@@ -0,0 +1,20 @@
+package packet
+
+import (
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/packethost/packngo"
+)
+
+const consumerToken = "aZ9GmqHTPtxevvFq9SK3Pi2yr9YCbRzduCSXF2SNem5sjB91mDq7Th3ZwTtRqMWZ"
+
+// Config holds the provider-level settings needed to talk to the Packet API.
+type Config struct {
+	AuthToken string
+}
+
+// Client returns a new packngo.Client built from the Config, using
+// cleanhttp's default transport so we don't inherit any proxy or TLS state
+// from http.DefaultClient.
+func (c *Config) Client() *packngo.Client {
+	return packngo.NewClient(consumerToken, c.AuthToken, cleanhttp.DefaultClient())
+}
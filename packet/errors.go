@@ -0,0 +1,49 @@
+package packet
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/packethost/packngo"
+)
+
+// ErrorResponse is a friendlier representation of a packngo.ErrorResponse. It
+// exposes the HTTP status code and the API's error messages directly so
+// callers don't have to dig through the underlying http.Response or resort
+// to matching on err.Error() strings.
+type ErrorResponse struct {
+	StatusCode int
+	Errors     []string
+}
+
+func (e *ErrorResponse) Error() string {
+	return fmt.Sprintf("%d: %s", e.StatusCode, strings.Join(e.Errors, ", "))
+}
+
+// friendlyError converts a *packngo.ErrorResponse into an *ErrorResponse. Any
+// other error is returned unchanged.
+func friendlyError(err error) error {
+	r, ok := err.(*packngo.ErrorResponse)
+	if !ok {
+		return err
+	}
+
+	return &ErrorResponse{
+		StatusCode: r.Response.StatusCode,
+		Errors:     r.Errors,
+	}
+}
+
+// isForbidden reports whether err is an *ErrorResponse for a 403 Forbidden,
+// which Packet returns when a device has been moved to an internal project.
+func isForbidden(err error) bool {
+	e, ok := err.(*ErrorResponse)
+	return ok && e.StatusCode == http.StatusForbidden
+}
+
+// isNotFound reports whether err is an *ErrorResponse for a 404 Not Found.
+func isNotFound(err error) bool {
+	e, ok := err.(*ErrorResponse)
+	return ok && e.StatusCode == http.StatusNotFound
+}
@@ -1,9 +1,8 @@
-package main
+package packet
 
 import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
-	"github.com/packethost/packngo"
 )
 
 // Provider returns a schema.Provider for DigitalOcean.
@@ -19,7 +18,12 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"packet_device": resourcePacketDevice(),
+			"packet_bgp_session":       resourcePacketBGPSession(),
+			"packet_device":            resourcePacketDevice(),
+			"packet_ip_attachment":     resourcePacketIPAttachment(),
+			"packet_project":           resourcePacketProject(),
+			"packet_reserved_ip_block": resourcePacketReservedIPBlock(),
+			"packet_ssh_key":           resourcePacketSSHKey(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -27,8 +31,9 @@ func Provider() terraform.ResourceProvider {
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
-	const (
-		consumerToken = "aZ9GmqHTPtxevvFq9SK3Pi2yr9YCbRzduCSXF2SNem5sjB91mDq7Th3ZwTtRqMWZ"
-	)
-	return packngo.NewClient(consumerToken, d.Get("api_key").(string)), nil
+	config := Config{
+		AuthToken: d.Get("api_key").(string),
+	}
+
+	return config.Client(), nil
 }
@@ -0,0 +1,103 @@
+package packet
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/packethost/packngo"
+)
+
+func resourcePacketBGPSession() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePacketBGPSessionCreate,
+		Read:   resourcePacketBGPSessionRead,
+		Delete: resourcePacketBGPSessionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"device_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"address_family": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"default_route": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePacketBGPSessionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*packngo.Client)
+
+	opts := &packngo.CreateBGPSessionRequest{
+		AddressFamily: d.Get("address_family").(string),
+		DefaultRoute:  d.Get("default_route").(bool),
+	}
+
+	log.Printf("[DEBUG] BGP session create configuration: %#v", opts)
+
+	session, _, err := client.BGPSessions.Create(d.Get("device_id").(string), opts)
+	if err != nil {
+		return fmt.Errorf("Error creating BGP session: %s", err)
+	}
+
+	d.SetId(session.ID)
+
+	log.Printf("[INFO] BGP session ID: %s", d.Id())
+
+	return resourcePacketBGPSessionRead(d, meta)
+}
+
+func resourcePacketBGPSessionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*packngo.Client)
+
+	session, _, err := client.BGPSessions.Get(d.Id())
+	if err != nil {
+		err = friendlyError(err)
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving BGP session: %s", err)
+	}
+
+	d.Set("address_family", session.AddressFamily)
+	d.Set("default_route", session.DefaultRoute)
+	d.Set("status", session.Status)
+
+	return nil
+}
+
+func resourcePacketBGPSessionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*packngo.Client)
+
+	log.Printf("[INFO] Deleting BGP session: %s", d.Id())
+
+	_, err := client.BGPSessions.Delete(d.Id())
+	if err != nil {
+		err = friendlyError(err)
+		if isNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting BGP session: %s", err)
+	}
+
+	return nil
+}
@@ -1,9 +1,8 @@
-package main
+package packet
 
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -18,6 +17,16 @@ func resourcePacketDevice() *schema.Resource {
 		Update: resourcePacketDeviceUpdate,
 		Delete: resourcePacketDeviceDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"os": &schema.Schema{
 				Type:     schema.TypeString,
@@ -72,6 +81,39 @@ func resourcePacketDevice() *schema.Resource {
 				Computed: true,
 			},
 
+			"network": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"cidr": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"gateway": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"public": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"family": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags": &schema.Schema{
 				Type:     schema.TypeList,
 				Optional: true,
@@ -79,8 +121,47 @@ func resourcePacketDevice() *schema.Resource {
 			},
 
 			"user_data": &schema.Schema{
-				Type:     schema.TypeString,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"user_data_part"},
+			},
+
+			"user_data_part": &schema.Schema{
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"user_data"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"content_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"filename": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"merge_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"ssh_key_ids": &schema.Schema{
+				Type:     schema.TypeList,
 				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 		},
 	}
@@ -103,6 +184,14 @@ func resourcePacketDeviceCreate(d *schema.ResourceData, meta interface{}) error
 		opts.UserData = attr.(string)
 	}
 
+	if parts, ok := d.GetOk("user_data_part"); ok {
+		userData, err := renderUserDataParts(parts.([]interface{}))
+		if err != nil {
+			return fmt.Errorf("Error rendering user_data_part: %s", err)
+		}
+		opts.UserData = userData
+	}
+
 	// Get configured tags
 	tags := d.Get("tags.#").(int)
 	if tags > 0 {
@@ -113,6 +202,16 @@ func resourcePacketDeviceCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	// Get configured SSH keys
+	sshKeyIDs := d.Get("ssh_key_ids.#").(int)
+	if sshKeyIDs > 0 {
+		opts.ProjectSSHKeys = make([]string, 0, sshKeyIDs)
+		for i := 0; i < sshKeyIDs; i++ {
+			key := fmt.Sprintf("ssh_key_ids.%d", i)
+			opts.ProjectSSHKeys = append(opts.ProjectSSHKeys, d.Get(key).(string))
+		}
+	}
+
 	log.Printf("[DEBUG] Device create configuration: %#v", opts)
 
 	dev, _, err := client.Devices.Create(opts)
@@ -126,7 +225,7 @@ func resourcePacketDeviceCreate(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[INFO] Device ID: %s", d.Id())
 
-	_, err = WaitForDeviceAttribute(d, "active", []string{"queued", "provisioning"}, "state", meta)
+	_, err = WaitForDeviceAttribute(d, "active", []string{"queued", "provisioning"}, "state", meta, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error waiting for device (%s) to become ready: %s", d.Id(), err)
 	}
@@ -140,9 +239,12 @@ func resourcePacketDeviceRead(d *schema.ResourceData, meta interface{}) error {
 	// Retrieve the device properties for updating the state
 	dev, _, err := client.Devices.Get(d.Id())
 	if err != nil {
-		// check if the device no longer exists.
-		// TODO: This is all wrong for Packet.
-		if strings.Contains(err.Error(), "404 Not Found") {
+		err = friendlyError(err)
+
+		// If the device is gone, or Packet has moved it to an internal
+		// project out from under us, drop it from state so the next
+		// apply can recreate it instead of failing forever.
+		if isNotFound(err) || isForbidden(err) {
 			d.SetId("")
 			return nil
 		}
@@ -156,8 +258,15 @@ func resourcePacketDeviceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("plan", dev.Plan.Slug)
 	d.Set("state", dev.State)
 	d.Set("locked", dev.Locked)
+	d.Set("user_data", dev.UserData)
+	d.Set("tags", dev.Tags)
+
+	if dev.Project != nil {
+		d.Set("project_id", dev.Project.ID)
+	}
 
 	var publicIPv4 string
+	networks := make([]map[string]interface{}, 0, len(dev.Network))
 	for _, addr := range dev.Network {
 		switch addr.Family {
 		case 4:
@@ -172,7 +281,16 @@ func resourcePacketDeviceRead(d *schema.ResourceData, meta interface{}) error {
 				d.Set("ipv6_address", addr.Address)
 			}
 		}
+
+		networks = append(networks, map[string]interface{}{
+			"address": addr.Address,
+			"cidr":    addr.CIDR,
+			"gateway": addr.Gateway,
+			"public":  addr.Public,
+			"family":  addr.Family,
+		})
 	}
+	d.Set("network", networks)
 
 	// Initialize the connection info
 	d.SetConnInfo(map[string]string{
@@ -186,7 +304,21 @@ func resourcePacketDeviceRead(d *schema.ResourceData, meta interface{}) error {
 func resourcePacketDeviceUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*packngo.Client)
 
-	// TODO: Support changing hostname.
+	if d.HasChange("hostname") {
+		opts := &packngo.DeviceUpdateRequest{}
+		hostname := d.Get("hostname").(string)
+		opts.Hostname = &hostname
+
+		_, _, err := client.Devices.Update(d.Id(), opts)
+		if err != nil {
+			err = friendlyError(err)
+			if isNotFound(err) || isForbidden(err) {
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("Error updating hostname for device (%s): %s", d.Id(), err)
+		}
+	}
 
 	if d.HasChange("locked") {
 		var (
@@ -201,6 +333,11 @@ func resourcePacketDeviceUpdate(d *schema.ResourceData, meta interface{}) error
 			_, err = client.Devices.Unlock(d.Id())
 		}
 		if err != nil {
+			err = friendlyError(err)
+			if isNotFound(err) || isForbidden(err) {
+				d.SetId("")
+				return nil
+			}
 			return fmt.Errorf("Error %s device (%s): %s", action, d.Id(), err)
 		}
 	}
@@ -211,7 +348,7 @@ func resourcePacketDeviceUpdate(d *schema.ResourceData, meta interface{}) error
 func resourcePacketDeviceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*packngo.Client)
 
-	_, err := WaitForDeviceAttribute(d, "active", []string{"queued", "provisioning"}, "state", meta)
+	_, err := WaitForDeviceAttribute(d, "active", []string{"queued", "provisioning"}, "state", meta, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("Error waiting for device to be active for destroy (%s): %s", d.Id(), err)
 	}
@@ -220,20 +357,22 @@ func resourcePacketDeviceDelete(d *schema.ResourceData, meta interface{}) error
 
 	// Destroy the device
 	_, err = client.Devices.Delete(d.Id())
+	if err != nil {
+		err = friendlyError(err)
 
-	// Handle remotely destroyed devices
-	if err != nil && strings.Contains(err.Error(), "404 Not Found") {
-		return nil
-	}
+		// Handle remotely destroyed devices, or ones Packet has already
+		// moved out of our project.
+		if isNotFound(err) || isForbidden(err) {
+			return nil
+		}
 
-	if err != nil {
 		return fmt.Errorf("Error deleting device: %s", err)
 	}
 
 	return nil
 }
 
-func WaitForDeviceAttribute(d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}) (interface{}, error) {
+func WaitForDeviceAttribute(d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}, timeout time.Duration) (interface{}, error) {
 	// Wait for the device so we can get the networking attributes
 	// that show up after a while
 	log.Printf(
@@ -244,7 +383,7 @@ func WaitForDeviceAttribute(d *schema.ResourceData, target string, pending []str
 		Pending:    pending,
 		Target:     target,
 		Refresh:    newDeviceStateRefreshFunc(d, attribute, meta),
-		Timeout:    60 * time.Minute,
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -275,7 +414,7 @@ func newDeviceStateRefreshFunc(d *schema.ResourceData, attribute string, meta in
 			// Retrieve the device properties
 			dev, _, err := client.Devices.Get(d.Id())
 			if err != nil {
-				return nil, "", fmt.Errorf("Error retrieving device: %s", err)
+				return nil, "", fmt.Errorf("Error retrieving device: %s", friendlyError(err))
 			}
 
 			return dev, attr.(string), nil
@@ -294,7 +433,7 @@ func powerOnAndWait(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Wait for power on
-	_, err = WaitForDeviceAttribute(d, "active", []string{"off"}, "state", client)
+	_, err = WaitForDeviceAttribute(d, "active", []string{"off"}, "state", client, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,100 @@
+package packet
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/packethost/packngo"
+)
+
+func resourcePacketIPAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePacketIPAttachmentCreate,
+		Read:   resourcePacketIPAttachmentRead,
+		Delete: resourcePacketIPAttachmentDelete,
+
+		Schema: map[string]*schema.Schema{
+			"device_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"cidr_notation": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"address": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"gateway": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePacketIPAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*packngo.Client)
+
+	opts := &packngo.AddressRequest{
+		Address: d.Get("cidr_notation").(string),
+	}
+
+	log.Printf("[DEBUG] IP attachment create configuration: %#v", opts)
+
+	attachment, _, err := client.DeviceIPs.Assign(d.Get("device_id").(string), opts)
+	if err != nil {
+		return fmt.Errorf("Error attaching IP to device: %s", err)
+	}
+
+	d.SetId(attachment.ID)
+
+	log.Printf("[INFO] IP attachment ID: %s", d.Id())
+
+	return resourcePacketIPAttachmentRead(d, meta)
+}
+
+func resourcePacketIPAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*packngo.Client)
+
+	attachment, _, err := client.DeviceIPs.Get(d.Id())
+	if err != nil {
+		err = friendlyError(err)
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving IP attachment: %s", err)
+	}
+
+	d.Set("address", attachment.Address)
+	d.Set("gateway", attachment.Gateway)
+
+	return nil
+}
+
+func resourcePacketIPAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*packngo.Client)
+
+	log.Printf("[INFO] Removing IP attachment: %s", d.Id())
+
+	_, err := client.DeviceIPs.Unassign(d.Id())
+	if err != nil {
+		err = friendlyError(err)
+		if isNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("Error removing IP attachment: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package packet
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/packethost/packngo"
+)
+
+func resourcePacketProject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePacketProjectCreate,
+		Read:   resourcePacketProjectRead,
+		Update: resourcePacketProjectUpdate,
+		Delete: resourcePacketProjectDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"payment_method": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"created": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePacketProjectCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*packngo.Client)
+
+	opts := &packngo.ProjectCreateRequest{
+		Name:            d.Get("name").(string),
+		PaymentMethodID: d.Get("payment_method").(string),
+	}
+
+	log.Printf("[DEBUG] Project create configuration: %#v", opts)
+
+	project, _, err := client.Projects.Create(opts)
+	if err != nil {
+		return fmt.Errorf("Error creating project: %s", err)
+	}
+
+	d.SetId(project.ID)
+
+	log.Printf("[INFO] Project ID: %s", d.Id())
+
+	return resourcePacketProjectRead(d, meta)
+}
+
+func resourcePacketProjectRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*packngo.Client)
+
+	project, _, err := client.Projects.Get(d.Id())
+	if err != nil {
+		err = friendlyError(err)
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving project: %s", err)
+	}
+
+	d.Set("name", project.Name)
+	if project.PaymentMethod != nil {
+		d.Set("payment_method", project.PaymentMethod.ID)
+	}
+	d.Set("created", project.Created)
+	d.Set("updated", project.Updated)
+
+	return nil
+}
+
+func resourcePacketProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*packngo.Client)
+
+	if d.HasChange("name") {
+		opts := &packngo.ProjectUpdateRequest{}
+		name := d.Get("name").(string)
+		opts.Name = &name
+
+		_, _, err := client.Projects.Update(d.Id(), opts)
+		if err != nil {
+			return fmt.Errorf("Error updating project (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourcePacketProjectRead(d, meta)
+}
+
+func resourcePacketProjectDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*packngo.Client)
+
+	log.Printf("[INFO] Deleting project: %s", d.Id())
+
+	_, err := client.Projects.Delete(d.Id())
+	if err != nil {
+		err = friendlyError(err)
+		if isNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting project: %s", err)
+	}
+
+	return nil
+}
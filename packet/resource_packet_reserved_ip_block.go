@@ -0,0 +1,120 @@
+package packet
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/packethost/packngo"
+)
+
+func resourcePacketReservedIPBlock() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePacketReservedIPBlockCreate,
+		Read:   resourcePacketReservedIPBlockRead,
+		Delete: resourcePacketReservedIPBlockDelete,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"facility": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"quantity": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"cidr_notation": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"address": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"gateway": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePacketReservedIPBlockCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*packngo.Client)
+
+	opts := &packngo.IPReservationRequest{
+		Type:     d.Get("type").(string),
+		Quantity: d.Get("quantity").(int),
+		Facility: d.Get("facility").(string),
+	}
+
+	log.Printf("[DEBUG] IP reservation create configuration: %#v", opts)
+
+	reservation, _, err := client.ProjectIPs.Create(d.Get("project_id").(string), opts)
+	if err != nil {
+		return fmt.Errorf("Error requesting IP reservation: %s", err)
+	}
+
+	d.SetId(reservation.ID)
+
+	log.Printf("[INFO] IP reservation ID: %s", d.Id())
+
+	return resourcePacketReservedIPBlockRead(d, meta)
+}
+
+func resourcePacketReservedIPBlockRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*packngo.Client)
+
+	reservation, _, err := client.ProjectIPs.Get(d.Id())
+	if err != nil {
+		err = friendlyError(err)
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving IP reservation: %s", err)
+	}
+
+	d.Set("address", reservation.Address)
+	d.Set("gateway", reservation.Gateway)
+	d.Set("cidr_notation", fmt.Sprintf("%s/%d", reservation.Network, reservation.CIDR))
+
+	return nil
+}
+
+func resourcePacketReservedIPBlockDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*packngo.Client)
+
+	log.Printf("[INFO] Removing IP reservation: %s", d.Id())
+
+	_, err := client.ProjectIPs.Remove(d.Id())
+	if err != nil {
+		err = friendlyError(err)
+		if isNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("Error removing IP reservation: %s", err)
+	}
+
+	return nil
+}
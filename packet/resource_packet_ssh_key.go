@@ -0,0 +1,128 @@
+package packet
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/packethost/packngo"
+)
+
+// resourcePacketSSHKey manages an account-wide Packet SSH key. packet_device
+// references these by ID via its own ssh_key_ids attribute.
+func resourcePacketSSHKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePacketSSHKeyCreate,
+		Read:   resourcePacketSSHKeyRead,
+		Update: resourcePacketSSHKeyUpdate,
+		Delete: resourcePacketSSHKeyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"public_key": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"fingerprint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePacketSSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*packngo.Client)
+
+	opts := &packngo.SSHKeyCreateRequest{
+		Label: d.Get("name").(string),
+		Key:   d.Get("public_key").(string),
+	}
+
+	log.Printf("[DEBUG] SSH key create configuration: %#v", opts)
+
+	key, _, err := client.SSHKeys.Create(opts)
+	if err != nil {
+		return fmt.Errorf("Error creating SSH key: %s", err)
+	}
+
+	d.SetId(key.ID)
+
+	log.Printf("[INFO] SSH key ID: %s", d.Id())
+
+	return resourcePacketSSHKeyRead(d, meta)
+}
+
+func resourcePacketSSHKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*packngo.Client)
+
+	key, _, err := client.SSHKeys.Get(d.Id())
+	if err != nil {
+		err = friendlyError(err)
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving SSH key: %s", err)
+	}
+
+	d.Set("name", key.Label)
+	d.Set("public_key", key.Key)
+	d.Set("fingerprint", key.FingerPrint)
+	d.Set("created", key.Created)
+	d.Set("updated", key.Updated)
+
+	return nil
+}
+
+func resourcePacketSSHKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*packngo.Client)
+
+	if d.HasChange("name") {
+		opts := &packngo.SSHKeyUpdateRequest{}
+		label := d.Get("name").(string)
+		opts.Label = &label
+
+		_, _, err := client.SSHKeys.Update(d.Id(), opts)
+		if err != nil {
+			return fmt.Errorf("Error updating SSH key (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourcePacketSSHKeyRead(d, meta)
+}
+
+func resourcePacketSSHKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*packngo.Client)
+
+	log.Printf("[INFO] Deleting SSH key: %s", d.Id())
+
+	_, err := client.SSHKeys.Delete(d.Id())
+	if err != nil {
+		err = friendlyError(err)
+		if isNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting SSH key: %s", err)
+	}
+
+	return nil
+}